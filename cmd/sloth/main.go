@@ -0,0 +1,201 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/Ferguzz/gl_textures/pkg/render"
+	"github.com/Ferguzz/glam"
+	"github.com/go-gl/gl"
+	glfw "github.com/go-gl/glfw3"
+	"math"
+)
+
+const vertexShaderSrc = `
+	#version 150
+
+	in vec2 position;
+	in vec2 textureCoord;
+	out vec2 TextureCoord;
+	uniform mat4 model;
+	uniform mat4 view;
+	uniform mat4 projection;
+
+	void main()
+	{
+	    TextureCoord = textureCoord;
+	    gl_Position = projection * view * model * vec4(position, 0.0, 1.0);
+	}
+	`
+
+var debugFlag = flag.Bool("debug", false, "check for GL errors and log GL_KHR_debug/ARB_debug_output messages")
+
+var rotate bool = true
+var blendModeDirty bool = false
+
+func errorCallback(err glfw.ErrorCode, desc string) {
+	fmt.Printf("%v: %v\n", err, desc)
+}
+
+func keyCallback(window *glfw.Window, key glfw.Key, scancode int, action glfw.Action, mods glfw.ModifierKey) {
+	if action == glfw.Press {
+		switch key {
+		case glfw.KeyEscape, glfw.KeyQ:
+			window.SetShouldClose(true)
+		case glfw.KeyR:
+			rotate = !rotate
+		case glfw.KeyB:
+			blendModeDirty = true
+		}
+	}
+}
+
+func glInit() (*glfw.Window, error) {
+	glfw.SetErrorCallback(errorCallback)
+
+	if !glfw.Init() {
+		return nil, errors.New("Can't initialise GLFW!")
+	}
+
+	glfw.WindowHint(glfw.ContextVersionMajor, 3)
+	glfw.WindowHint(glfw.ContextVersionMinor, 2)
+	glfw.WindowHint(glfw.OpenglProfile, glfw.OpenglCoreProfile)
+	glfw.WindowHint(glfw.OpenglForwardCompatible, gl.TRUE)
+
+	window, err := glfw.CreateWindow(640, 480, "OpenGL Textures", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	window.SetKeyCallback(keyCallback)
+	window.MakeContextCurrent()
+	if gl.Init() != 0 {
+		return nil, errors.New("Can't initialise OpenGL.")
+	}
+
+	return window, nil
+}
+
+func glExit() {
+	glfw.Terminate()
+}
+
+func main() {
+	flag.Parse()
+	render.Debug = *debugFlag
+
+	window, err := glInit()
+	if err != nil {
+		panic(err)
+	}
+	defer glExit()
+
+	if render.Debug {
+		if err := render.EnableDebugOutput(); err != nil {
+			fmt.Println(err)
+		}
+	}
+
+	mesh := render.NewMesh(
+		[]gl.GLfloat{-0.5, -0.5, 0, 1, 0.5, -0.5, 1, 1, -0.5, 0.5, 0, 0, 0.5, 0.5, 1, 0},
+		[]gl.GLushort{0, 1, 2, 3},
+		[]render.Attribute{
+			{Name: "position", Size: 2, Type: gl.FLOAT, Stride: 4 * 4, Offset: uintptr(0)},
+			{Name: "textureCoord", Size: 2, Type: gl.FLOAT, Stride: 4 * 4, Offset: uintptr(2 * 4)},
+		},
+	)
+	defer mesh.Delete()
+
+	if err := render.CheckGLErrors("mesh setup"); err != nil {
+		fmt.Println(err)
+	}
+
+	textureLoader := render.NewTextureLoader()
+	textureOptions := render.DefaultTextureOptions
+	textureOptions.Mipmap = true
+	textureOptions.Anisotropy = true
+
+	texture1, err := textureLoader.Load("sloth_n_banana.jpg", gl.TEXTURE0, textureOptions)
+	if err != nil {
+		panic(err)
+	}
+	defer texture1.Delete()
+
+	texture2, err := textureLoader.Load("sloth_n_kebab.jpg", gl.TEXTURE1, textureOptions)
+	if err != nil {
+		panic(err)
+	}
+	defer texture2.Delete()
+
+	if err := render.CheckGLErrors("texture upload"); err != nil {
+		fmt.Println(err)
+	}
+
+	material := render.Material{
+		Units: []render.TextureUnit{
+			{Texture: texture1.GL, Unit: texture1.Unit, BlendFactor: 1.0},
+			{Texture: texture2.GL, Unit: texture2.Unit, BlendFactor: 0.5},
+		},
+		Mode: render.BlendSingle,
+	}
+
+	program, err := render.NewProgram(vertexShaderSrc, material.FragmentShader())
+	if err != nil {
+		panic(err)
+	}
+	defer program.Delete()
+	mesh.BindAttributes(program)
+
+	if err := render.CheckGLErrors("shader compile/link"); err != nil {
+		fmt.Println(err)
+	}
+
+	camera := render.Camera{
+		Eye: glam.Vec3{1, 0, 1}, Center: glam.Vec3{0, 0, 0}, Up: glam.Vec3{0, 0, 1},
+		Fov: 45, Aspect: 640 / 480, Near: 1, Far: 10,
+	}
+
+	scene := &render.Scene{
+		Drawables: []render.Drawable{
+			{Mesh: mesh, Program: program, Material: material, Mode: gl.TRIANGLE_STRIP},
+		},
+	}
+
+	spinCount := 0.0
+	modelMat := glam.Identity()
+
+	for !window.ShouldClose() {
+		gl.ClearColor(0.0, 0.0, 0.0, 1.0)
+		gl.Clear(gl.COLOR_BUFFER_BIT)
+
+		if blendModeDirty {
+			material.Mode = material.Mode.Next()
+			scene.Drawables[0].Material = material
+			if err := program.Reload(vertexShaderSrc, material.FragmentShader()); err != nil {
+				fmt.Printf("blend mode %v shader rebuild failed, keeping previous program: %v\n", material.Mode, err)
+			} else {
+				mesh.BindAttributes(program)
+			}
+			blendModeDirty = false
+		}
+
+		if rotate {
+			modelMat = glam.Rotation(float32(spinCount*math.Pi), glam.Vec3{0, 0, 1})
+			spinCount += 0.0005
+		}
+
+		scene.Drawables[0].Uniforms = map[string]glam.Mat4{
+			"model":      modelMat,
+			"view":       camera.View(),
+			"projection": camera.Projection(),
+		}
+		scene.Draw()
+
+		if err := render.CheckGLErrors("draw"); err != nil {
+			fmt.Println(err)
+		}
+
+		window.SwapBuffers()
+		glfw.PollEvents()
+	}
+}
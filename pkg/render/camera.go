@@ -0,0 +1,24 @@
+package render
+
+import (
+	"github.com/Ferguzz/glam"
+)
+
+// Camera holds the parameters needed to build the view and projection
+// matrices for a perspective camera.
+type Camera struct {
+	Eye, Center, Up glam.Vec3
+	Fov             float32
+	Aspect          float32
+	Near, Far       float32
+}
+
+// Projection returns the camera's perspective projection matrix.
+func (c Camera) Projection() glam.Mat4 {
+	return glam.Perspective(c.Fov, c.Aspect, c.Near, c.Far)
+}
+
+// View returns the camera's look-at view matrix.
+func (c Camera) View() glam.Mat4 {
+	return glam.LookAt(c.Eye, c.Center, c.Up)
+}
@@ -0,0 +1,102 @@
+package render
+
+import (
+	"errors"
+	"fmt"
+	"github.com/go-gl/gl"
+	"log"
+	"strings"
+)
+
+// Enable() targets for KHR_debug / ARB_debug_output; go-gl/gl has no
+// constants for these two targets.
+const (
+	glDebugOutput            = gl.GLenum(0x92E0)
+	glDebugOutputSynchronous = gl.GLenum(0x8242)
+)
+
+// Debug gates CheckGLErrors and should be flipped on by callers (e.g. from
+// a -debug CLI flag). Every debug-only check in this file respects it so
+// release builds pay no per-frame cost.
+var Debug bool
+
+func glErrorString(code gl.GLenum) string {
+	switch code {
+	case gl.INVALID_ENUM:
+		return "INVALID_ENUM"
+	case gl.INVALID_VALUE:
+		return "INVALID_VALUE"
+	case gl.INVALID_OPERATION:
+		return "INVALID_OPERATION"
+	case gl.OUT_OF_MEMORY:
+		return "OUT_OF_MEMORY"
+	case gl.INVALID_FRAMEBUFFER_OPERATION:
+		return "INVALID_FRAMEBUFFER_OPERATION"
+	case gl.STACK_OVERFLOW:
+		return "STACK_OVERFLOW"
+	case gl.STACK_UNDERFLOW:
+		return "STACK_UNDERFLOW"
+	default:
+		return fmt.Sprintf("unknown GL error 0x%X", uint32(code))
+	}
+}
+
+// CheckGLErrors drains gl.GetError() and, if it returned anything other
+// than NO_ERROR, returns a single error joining every pending error code
+// with context identifying the call site that triggered the check. It is a
+// no-op (always nil, without touching the driver) unless Debug is set.
+func CheckGLErrors(context string) error {
+	if !Debug {
+		return nil
+	}
+
+	var errs []string
+	for {
+		code := gl.GetError()
+		if code == gl.NO_ERROR {
+			break
+		}
+		errs = append(errs, glErrorString(code))
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+	return errors.New(fmt.Sprintf("%s: %s", context, strings.Join(errs, ", ")))
+}
+
+func glDebugSeverityString(severity gl.GLenum) string {
+	switch severity {
+	case gl.DEBUG_SEVERITY_HIGH:
+		return "HIGH"
+	case gl.DEBUG_SEVERITY_MEDIUM:
+		return "MEDIUM"
+	case gl.DEBUG_SEVERITY_LOW:
+		return "LOW"
+	default:
+		return "NOTIFICATION"
+	}
+}
+
+func debugMessageCallback(source, msgType, id, severity gl.GLenum, message string) {
+	if severity == gl.DEBUG_SEVERITY_NOTIFICATION {
+		return
+	}
+	log.Printf("[GL %s] %s", glDebugSeverityString(severity), message)
+}
+
+// EnableDebugOutput registers debugMessageCallback with the driver if
+// GL_KHR_debug or GL_ARB_debug_output is available, routing GL's own
+// diagnostic messages through the log package instead of requiring manual
+// CheckGLErrors calls everywhere.
+func EnableDebugOutput() error {
+	exts := gl.GetString(gl.EXTENSIONS)
+	if !strings.Contains(exts, "GL_KHR_debug") && !strings.Contains(exts, "GL_ARB_debug_output") {
+		return errors.New("debug: neither GL_KHR_debug nor GL_ARB_debug_output is available")
+	}
+
+	gl.Enable(glDebugOutput)
+	gl.Enable(glDebugOutputSynchronous)
+	gl.DebugMessageCallback(debugMessageCallback)
+	return nil
+}
@@ -0,0 +1,123 @@
+package render
+
+import (
+	"fmt"
+	"github.com/go-gl/gl"
+)
+
+// TextureUnit pairs a GL texture with the texture unit (gl.TEXTURE0,
+// gl.TEXTURE1, ...) it is bound to, an optional sampler uniform name and the
+// unit's blend factor. It does not carry the texture's sampler parameters;
+// those stay on the texture as set when it was uploaded.
+type TextureUnit struct {
+	Texture     gl.Texture
+	Unit        gl.GLenum
+	UniformName string
+	BlendFactor float32
+}
+
+// Material is an ordered list of texture units whose first two are sampled
+// and combined by the fragment shader according to a BlendMode. Reordering
+// Units changes which sampler is tex0, tex1, ... in the generated shader.
+type Material struct {
+	Units []TextureUnit
+	Mode  BlendMode
+}
+
+// BlendMode selects how a Material's texture units are combined in the
+// fragment shader.
+type BlendMode int
+
+const (
+	BlendSingle BlendMode = iota
+	BlendMix
+	BlendMultiply
+	BlendAdd
+)
+
+func (m BlendMode) String() string {
+	switch m {
+	case BlendSingle:
+		return "single"
+	case BlendMix:
+		return "mix"
+	case BlendMultiply:
+		return "multiply"
+	case BlendAdd:
+		return "add"
+	default:
+		return "unknown"
+	}
+}
+
+// Next cycles single -> mix -> multiply -> add -> single.
+func (m BlendMode) Next() BlendMode {
+	return (m + 1) % 4
+}
+
+// FragmentShader generates a fragment shader source that declares a sampler
+// and blendFactor uniform for every unit in the material, and combines the
+// first two units according to mat.Mode; units after the second are not
+// sampled. With a single unit the result just samples tex0, regardless of
+// mode, and with no units it is opaque black.
+func (mat Material) FragmentShader() string {
+	header := "#version 150\n\n\tout vec4 outColor;\n\tin vec2 TextureCoord;\n"
+	for i, unit := range mat.Units {
+		header += fmt.Sprintf("\tuniform sampler2D %s;\n", samplerName(i, unit))
+		header += fmt.Sprintf("\tuniform float blendFactor%d;\n", i)
+	}
+
+	body := "\n\tvoid main()\n\t{\n"
+	if len(mat.Units) == 0 {
+		body += "\t    outColor = vec4(0.0, 0.0, 0.0, 1.0);\n"
+	} else {
+		sample := func(i int) string {
+			return fmt.Sprintf("texture(%s, TextureCoord)", samplerName(i, mat.Units[i]))
+		}
+
+		if len(mat.Units) == 1 {
+			body += fmt.Sprintf("\t    outColor = %s;\n", sample(0))
+		} else {
+			switch mat.Mode {
+			case BlendMix:
+				body += fmt.Sprintf("\t    outColor = mix(%s, %s, blendFactor1);\n", sample(0), sample(1))
+			case BlendMultiply:
+				body += fmt.Sprintf("\t    outColor = %s * %s;\n", sample(0), sample(1))
+			case BlendAdd:
+				body += fmt.Sprintf("\t    outColor = %s + %s;\n", sample(0), sample(1))
+			default:
+				body += fmt.Sprintf("\t    outColor = %s;\n", sample(0))
+			}
+		}
+	}
+	body += "\t}\n"
+
+	return header + body
+}
+
+func samplerName(i int, unit TextureUnit) string {
+	if unit.UniformName != "" {
+		return unit.UniformName
+	}
+	return fmt.Sprintf("tex%d", i)
+}
+
+// Bind activates and binds every texture unit so the shader's samplers can
+// be set to the matching texture unit indices.
+func (mat Material) Bind() {
+	for _, unit := range mat.Units {
+		gl.ActiveTexture(unit.Unit)
+		unit.Texture.Bind(gl.TEXTURE_2D)
+	}
+}
+
+// ApplyUniforms sets the tex0/tex1/... and blendFactor0/1/... uniforms on
+// program to match mat.Units. The sampler value is the unit's actual GL
+// texture unit index (unit.Unit - gl.TEXTURE0), not its position in Units,
+// so Bind and ApplyUniforms agree regardless of ordering.
+func (mat Material) ApplyUniforms(program *Program) {
+	for i, unit := range mat.Units {
+		program.Uniform(samplerName(i, unit)).Uniform1i(int(unit.Unit - gl.TEXTURE0))
+		program.Uniform(fmt.Sprintf("blendFactor%d", i)).Uniform1f(unit.BlendFactor)
+	}
+}
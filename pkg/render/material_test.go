@@ -0,0 +1,46 @@
+package render
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBlendModeNext(t *testing.T) {
+	got := BlendSingle
+	want := []BlendMode{BlendMix, BlendMultiply, BlendAdd, BlendSingle}
+	for _, w := range want {
+		got = got.Next()
+		if got != w {
+			t.Errorf("Next() = %v, want %v", got, w)
+		}
+	}
+}
+
+func TestMaterialFragmentShaderSingleUnit(t *testing.T) {
+	mat := Material{Units: []TextureUnit{{}}, Mode: BlendAdd}
+	src := mat.FragmentShader()
+
+	if !strings.Contains(src, "outColor = texture(tex0, TextureCoord);") {
+		t.Errorf("single-unit material should sample tex0 directly regardless of Mode, got:\n%s", src)
+	}
+}
+
+func TestMaterialFragmentShaderModes(t *testing.T) {
+	cases := []struct {
+		mode BlendMode
+		want string
+	}{
+		{BlendSingle, "outColor = texture(tex0, TextureCoord);"},
+		{BlendMix, "outColor = mix(texture(tex0, TextureCoord), texture(tex1, TextureCoord), blendFactor1);"},
+		{BlendMultiply, "outColor = texture(tex0, TextureCoord) * texture(tex1, TextureCoord);"},
+		{BlendAdd, "outColor = texture(tex0, TextureCoord) + texture(tex1, TextureCoord);"},
+	}
+
+	for _, c := range cases {
+		mat := Material{Units: []TextureUnit{{}, {}}, Mode: c.mode}
+		src := mat.FragmentShader()
+		if !strings.Contains(src, c.want) {
+			t.Errorf("mode %v: fragment shader missing %q, got:\n%s", c.mode, c.want, src)
+		}
+	}
+}
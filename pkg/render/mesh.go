@@ -0,0 +1,70 @@
+package render
+
+import (
+	"github.com/go-gl/gl"
+)
+
+// Attribute describes one vertex attribute within a Mesh's interleaved
+// vertex buffer: Name is looked up in a Program via GetAttribLocation.
+type Attribute struct {
+	Name   string
+	Size   int
+	Type   gl.GLenum
+	Stride int
+	Offset uintptr
+}
+
+// Mesh is a VAO plus the VBO/EBO backing it, along with the attribute
+// layout needed to bind it to a Program.
+type Mesh struct {
+	vao        gl.VertexArray
+	vbo        gl.Buffer
+	ebo        gl.Buffer
+	attributes []Attribute
+	count      int
+}
+
+// NewMesh uploads vertices and elements as STATIC_DRAW buffers and records
+// attributes for later binding via BindAttributes.
+func NewMesh(vertices []gl.GLfloat, elements []gl.GLushort, attributes []Attribute) *Mesh {
+	vao := gl.GenVertexArray()
+	vao.Bind()
+
+	vbo := gl.GenBuffer()
+	vbo.Bind(gl.ARRAY_BUFFER)
+	gl.BufferData(gl.ARRAY_BUFFER, len(vertices)*4, vertices, gl.STATIC_DRAW)
+
+	ebo := gl.GenBuffer()
+	ebo.Bind(gl.ELEMENT_ARRAY_BUFFER)
+	gl.BufferData(gl.ELEMENT_ARRAY_BUFFER, len(elements)*2, elements, gl.STATIC_DRAW)
+
+	return &Mesh{vao: vao, vbo: vbo, ebo: ebo, attributes: attributes, count: len(elements)}
+}
+
+// BindAttributes looks up each attribute by name in program and enables its
+// vertex array. AttribPointer records offsets against whatever is bound to
+// GL_ARRAY_BUFFER, so m.vbo is (re-)bound explicitly rather than trusting
+// that nothing else touched that binding since NewMesh.
+func (m *Mesh) BindAttributes(program *Program) {
+	m.vao.Bind()
+	m.vbo.Bind(gl.ARRAY_BUFFER)
+	for _, a := range m.attributes {
+		attrib := program.GL.GetAttribLocation(a.Name)
+		attrib.AttribPointer(a.Size, a.Type, false, a.Stride, a.Offset)
+		attrib.EnableArray()
+	}
+}
+
+// Draw binds the mesh's VAO and issues a DrawElements call over its element
+// buffer using the given primitive mode.
+func (m *Mesh) Draw(mode gl.GLenum) {
+	m.vao.Bind()
+	gl.DrawElements(mode, m.count, gl.UNSIGNED_SHORT, uintptr(0))
+}
+
+// Delete frees the mesh's VAO, VBO and EBO.
+func (m *Mesh) Delete() {
+	m.vao.Delete()
+	m.vbo.Delete()
+	m.ebo.Delete()
+}
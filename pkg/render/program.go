@@ -0,0 +1,106 @@
+// Package render is a small reusable helper library for the textured-quad
+// demo: shader programs, meshes, cameras and a scene graph thin enough to
+// draw them. It wraps github.com/go-gl/gl without hiding it — callers are
+// expected to still reach for gl directly when they need something the
+// wrappers don't cover.
+package render
+
+import (
+	"errors"
+	"fmt"
+	"github.com/go-gl/gl"
+)
+
+// Program wraps a linked GL shader program together with a cache of
+// uniform locations, so repeated lookups of the same uniform name don't
+// round-trip to the driver.
+type Program struct {
+	GL       gl.Program
+	uniforms map[string]gl.UniformLocation
+}
+
+func compileShader(shaderType gl.GLenum, source string) (gl.Shader, error) {
+	shader := gl.CreateShader(shaderType)
+	shader.Source(source)
+	shader.Compile()
+
+	if shader.Get(gl.COMPILE_STATUS) == 0 {
+		return shader, errors.New(fmt.Sprintf("shader did not compile: %s", shader.GetInfoLog()))
+	}
+
+	return shader, nil
+}
+
+func linkProgram(vsSrc, fsSrc string) (gl.Program, error) {
+	vertexShader, err := compileShader(gl.VERTEX_SHADER, vsSrc)
+	if err != nil {
+		return gl.Program(0), err
+	}
+	defer vertexShader.Delete()
+
+	fragmentShader, err := compileShader(gl.FRAGMENT_SHADER, fsSrc)
+	if err != nil {
+		return gl.Program(0), err
+	}
+	defer fragmentShader.Delete()
+
+	program := gl.CreateProgram()
+	program.AttachShader(vertexShader)
+	program.AttachShader(fragmentShader)
+	program.BindFragDataLocation(0, "outColor")
+	program.Link()
+
+	if program.Get(gl.LINK_STATUS) == 0 {
+		logLength := program.Get(gl.INFO_LOG_LENGTH)
+		err := errors.New(fmt.Sprintf("program did not link (log length %d): %s", logLength, program.GetInfoLog()))
+		program.Delete()
+		return gl.Program(0), err
+	}
+
+	return program, nil
+}
+
+// NewProgram compiles and links vsSrc/fsSrc into a ready-to-use Program.
+func NewProgram(vsSrc, fsSrc string) (*Program, error) {
+	program, err := linkProgram(vsSrc, fsSrc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Program{GL: program, uniforms: make(map[string]gl.UniformLocation)}, nil
+}
+
+// Use installs p as the current program.
+func (p *Program) Use() {
+	p.GL.Use()
+}
+
+// Uniform returns the (cached) location of the named uniform in p.
+func (p *Program) Uniform(name string) gl.UniformLocation {
+	if loc, ok := p.uniforms[name]; ok {
+		return loc
+	}
+	loc := p.GL.GetUniformLocation(name)
+	p.uniforms[name] = loc
+	return loc
+}
+
+// Reload compiles and links vsSrc/fsSrc, swapping the result in for p.GL
+// only if the link succeeds. On failure p is left untouched so the caller
+// can keep rendering with the last good program.
+func (p *Program) Reload(vsSrc, fsSrc string) error {
+	newProgram, err := linkProgram(vsSrc, fsSrc)
+	if err != nil {
+		return err
+	}
+
+	p.GL.Delete()
+	p.GL = newProgram
+	p.uniforms = make(map[string]gl.UniformLocation)
+	return nil
+}
+
+// Delete frees the underlying GL program.
+func (p *Program) Delete() {
+	p.GL.Delete()
+}
@@ -0,0 +1,42 @@
+package render
+
+import (
+	"github.com/Ferguzz/glam"
+	"github.com/go-gl/gl"
+)
+
+// Drawable is everything needed to render one object: its geometry, the
+// program to render it with, the textures that program samples, and the
+// per-draw uniform matrices (typically "model", "view", "projection").
+type Drawable struct {
+	Mesh     *Mesh
+	Program  *Program
+	Material Material
+	Uniforms map[string]glam.Mat4
+	Mode     gl.GLenum
+}
+
+// Scene is an ordered list of Drawables rendered once per Draw call.
+type Scene struct {
+	Drawables []Drawable
+}
+
+// Draw renders every Drawable in the scene, in order.
+func (s *Scene) Draw() {
+	for _, d := range s.Drawables {
+		d.Program.Use()
+		d.Material.Bind()
+		d.Material.ApplyUniforms(d.Program)
+		d.Mesh.BindAttributes(d.Program)
+
+		for name, mat := range d.Uniforms {
+			d.Program.Uniform(name).UniformMatrix4fv(false, mat)
+		}
+
+		mode := d.Mode
+		if mode == 0 {
+			mode = gl.TRIANGLE_STRIP
+		}
+		d.Mesh.Draw(mode)
+	}
+}
@@ -0,0 +1,105 @@
+package render
+
+import (
+	"errors"
+	"github.com/go-gl/gl"
+	"unsafe"
+)
+
+// glMapBufferRange's access argument is a bitfield of these, none of which
+// go-gl/gl exposes as constants, so they're spelled out by hand here.
+const (
+	mapWriteBit            = gl.GLbitfield(0x0002)
+	mapInvalidateBufferBit = gl.GLbitfield(0x0008)
+	mapUnsynchronizedBit   = gl.GLbitfield(0x0020)
+)
+
+// StreamingTexture uploads pixel data through a ring of Pixel Buffer
+// Objects instead of a synchronous TexSubImage2D, so the CPU never has to
+// wait on a GPU read of the texture it's about to overwrite. This is the
+// trick behind fast video/webcam textures: while the GPU is still reading
+// PBO[i-1] for a previous TexSubImage2D, the CPU writes into PBO[i].
+type StreamingTexture struct {
+	GL     gl.Texture
+	Unit   gl.GLenum
+	Width  int
+	Height int
+	pbos   []gl.Buffer
+	next   int
+}
+
+// NewStreamingTexture allocates a Width x Height RGBA texture on unit and a
+// ring of ringSize PBOs used to stream pixel data into it. ringSize must be
+// at least 1, or Update would index an empty ring.
+func NewStreamingTexture(w, h int, ringSize int, unit gl.GLenum) (*StreamingTexture, error) {
+	if ringSize < 1 {
+		return nil, errors.New("streaming texture: ringSize must be at least 1")
+	}
+
+	texture := gl.GenTexture()
+	gl.ActiveTexture(unit)
+	texture.Bind(gl.TEXTURE_2D)
+	gl.TexImage2D(gl.TEXTURE_2D, 0, gl.RGBA, w, h, 0, gl.RGBA, gl.UNSIGNED_BYTE, nil)
+	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MIN_FILTER, gl.LINEAR)
+	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MAG_FILTER, gl.LINEAR)
+
+	pbos := make([]gl.Buffer, ringSize)
+	for i := range pbos {
+		pbos[i] = gl.GenBuffer()
+	}
+
+	return &StreamingTexture{
+		GL:     texture,
+		Unit:   unit,
+		Width:  w,
+		Height: h,
+		pbos:   pbos,
+	}, nil
+}
+
+// Update uploads pix (tightly packed RGBA, Width*Height*4 bytes) through the
+// next PBO in the ring: the store is orphaned, mapped unsynchronized so the
+// driver doesn't stall waiting for any in-flight read of it, written, then
+// handed to TexSubImage2D as the upload source so the copy to the texture
+// happens asynchronously via DMA.
+func (s *StreamingTexture) Update(pix []byte) error {
+	if len(pix) != s.Width*s.Height*4 {
+		return errors.New("streaming texture: pix size does not match width*height*4")
+	}
+
+	pbo := s.pbos[s.next]
+	s.next = (s.next + 1) % len(s.pbos)
+
+	pbo.Bind(gl.PIXEL_UNPACK_BUFFER)
+	gl.BufferData(gl.PIXEL_UNPACK_BUFFER, len(pix), nil, gl.STREAM_DRAW)
+
+	ptr := gl.MapBufferRange(gl.PIXEL_UNPACK_BUFFER, 0, len(pix), mapWriteBit|mapInvalidateBufferBit|mapUnsynchronizedBit)
+	if ptr == nil {
+		gl.Buffer(0).Bind(gl.PIXEL_UNPACK_BUFFER)
+		return errors.New("streaming texture: MapBufferRange failed")
+	}
+	copyToMappedBuffer(ptr, pix)
+	gl.UnmapBuffer(gl.PIXEL_UNPACK_BUFFER)
+
+	gl.ActiveTexture(s.Unit)
+	s.GL.Bind(gl.TEXTURE_2D)
+	gl.TexSubImage2D(gl.TEXTURE_2D, 0, 0, 0, s.Width, s.Height, gl.RGBA, gl.UNSIGNED_BYTE, uintptr(0))
+
+	gl.Buffer(0).Bind(gl.PIXEL_UNPACK_BUFFER)
+	return nil
+}
+
+// copyToMappedBuffer copies pix into the memory ptr points at, as returned
+// by MapBufferRange.
+func copyToMappedBuffer(ptr unsafe.Pointer, pix []byte) {
+	dst := (*[1 << 30]byte)(ptr)[:len(pix):len(pix)]
+	copy(dst, pix)
+}
+
+// Delete frees the texture and all PBOs in the ring.
+func (s *StreamingTexture) Delete() {
+	s.GL.Delete()
+	for _, pbo := range s.pbos {
+		pbo.Delete()
+	}
+}
@@ -0,0 +1,203 @@
+package render
+
+import (
+	"fmt"
+	"github.com/go-gl/gl"
+	xdraw "golang.org/x/image/draw"
+	"image"
+	"image/draw"
+	_ "image/jpeg"
+	_ "image/png"
+	"os"
+	"strings"
+)
+
+// go-gl/gl predates GL_EXT_texture_filter_anisotropic, so its two enums are
+// declared by hand: the per-texture sampler parameter used with
+// TexParameterf, and the separate global-state enum used to ask the driver
+// what the largest anisotropy it supports is.
+const (
+	glTextureMaxAnisotropyExt    = gl.GLenum(0x84FE)
+	glMaxTextureMaxAnisotropyExt = gl.GLenum(0x84FF)
+)
+
+// TextureOptions controls how a TextureLoader uploads and samples a texture.
+type TextureOptions struct {
+	Mipmap     bool
+	Anisotropy bool
+	WrapS      gl.GLenum
+	WrapT      gl.GLenum
+	MinFilter  gl.GLenum
+	MagFilter  gl.GLenum
+}
+
+// DefaultTextureOptions is bilinear filtering with no mipmaps, matching the
+// original demo's behaviour before TextureOptions existed.
+var DefaultTextureOptions = TextureOptions{
+	MinFilter: gl.LINEAR,
+	MagFilter: gl.LINEAR,
+}
+
+// Texture is a loaded, GL-resident texture together with the unit it's bound
+// to and the options it was loaded with, so it can be rebound later without
+// re-decoding or re-uploading.
+type Texture struct {
+	GL      gl.Texture
+	Unit    gl.GLenum
+	Width   int
+	Height  int
+	Options TextureOptions
+}
+
+// Bind activates t's texture unit and binds its GL texture.
+func (t Texture) Bind() {
+	gl.ActiveTexture(t.Unit)
+	t.GL.Bind(gl.TEXTURE_2D)
+}
+
+// Delete frees the underlying GL texture.
+func (t Texture) Delete() {
+	t.GL.Delete()
+}
+
+// TextureLoader decodes and uploads textures, caching the result by
+// filename, texture unit and options so repeated loads of the same
+// combination reuse the same gl.Texture instead of re-uploading.
+type TextureLoader struct {
+	cache map[string]Texture
+}
+
+// NewTextureLoader returns a TextureLoader with an empty cache.
+func NewTextureLoader() *TextureLoader {
+	return &TextureLoader{cache: make(map[string]Texture)}
+}
+
+func textureCacheKey(filename string, unit gl.GLenum, opts TextureOptions) string {
+	return fmt.Sprintf("%s|%v|%+v", filename, unit, opts)
+}
+
+func loadImage(filename string) (*image.NRGBA, error) {
+	src, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer src.Close()
+
+	img, _, err := image.Decode(src)
+	if err != nil {
+		return nil, err
+	}
+
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+
+	nrgbaImg, ok := img.(*image.NRGBA)
+	if !ok {
+		nrgbaImg = image.NewNRGBA(image.Rect(0, 0, width, height))
+		draw.Draw(nrgbaImg, nrgbaImg.Bounds(), img, bounds.Min, draw.Src)
+	}
+
+	return nrgbaImg, nil
+}
+
+// Load decodes filename (unless already cached for this unit/opts
+// combination), rescales it to power-of-two dimensions if necessary,
+// uploads it to unit and applies opts.
+func (l *TextureLoader) Load(filename string, unit gl.GLenum, opts TextureOptions) (Texture, error) {
+	key := textureCacheKey(filename, unit, opts)
+	if cached, ok := l.cache[key]; ok {
+		return cached, nil
+	}
+
+	img, err := loadImage(filename)
+	if err != nil {
+		return Texture{}, err
+	}
+
+	texture := UploadImage(img, unit, opts)
+	l.cache[key] = texture
+	return texture, nil
+}
+
+// UploadImage rescales img to power-of-two dimensions if necessary and
+// uploads it to unit with opts applied. Unlike TextureLoader.Load, it
+// neither decodes a file nor caches the result - it's for callers that
+// already have pixels in hand, such as the text package's glyph atlas.
+func UploadImage(img *image.NRGBA, unit gl.GLenum, opts TextureOptions) Texture {
+	img = rescaleToPowerOfTwo(img)
+	dims := img.Bounds()
+
+	texture := Texture{
+		GL:      gl.GenTexture(),
+		Unit:    unit,
+		Width:   dims.Dx(),
+		Height:  dims.Dy(),
+		Options: opts,
+	}
+
+	gl.ActiveTexture(unit)
+	texture.GL.Bind(gl.TEXTURE_2D)
+	gl.TexImage2D(gl.TEXTURE_2D, 0, gl.RGBA, dims.Dx(), dims.Dy(), 0, gl.RGBA, gl.UNSIGNED_BYTE, img.Pix)
+
+	minFilter := opts.MinFilter
+	if opts.Mipmap {
+		gl.GenerateMipmap(gl.TEXTURE_2D)
+		minFilter = gl.LINEAR_MIPMAP_LINEAR
+	}
+	if minFilter != 0 {
+		gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MIN_FILTER, minFilter)
+	}
+	if opts.MagFilter != 0 {
+		gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MAG_FILTER, opts.MagFilter)
+	}
+	if opts.WrapS != 0 {
+		gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_WRAP_S, opts.WrapS)
+	}
+	if opts.WrapT != 0 {
+		gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_WRAP_T, opts.WrapT)
+	}
+
+	if opts.Anisotropy {
+		if maxAniso := queryMaxAnisotropy(); maxAniso > 0 {
+			gl.TexParameterf(gl.TEXTURE_2D, glTextureMaxAnisotropyExt, maxAniso)
+		}
+	}
+
+	return texture
+}
+
+// queryMaxAnisotropy returns the driver's maximum anisotropy level, or 0 if
+// GL_EXT_texture_filter_anisotropic isn't supported.
+func queryMaxAnisotropy() gl.GLfloat {
+	if !strings.Contains(gl.GetString(gl.EXTENSIONS), "GL_EXT_texture_filter_anisotropic") {
+		return 0
+	}
+	return gl.GetFloat(glMaxTextureMaxAnisotropyExt)
+}
+
+// nextPowerOfTwo returns the smallest power of two >= n.
+func nextPowerOfTwo(n int) int {
+	p := 1
+	for p < n {
+		p <<= 1
+	}
+	return p
+}
+
+// rescaleToPowerOfTwo returns img unchanged if its dimensions are already
+// powers of two, otherwise rescales it up to the next power of two using a
+// high quality Catmull-Rom filter, the same approach the gltext example uses
+// to pack glyphs into a POT atlas.
+func rescaleToPowerOfTwo(img *image.NRGBA) *image.NRGBA {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	potWidth, potHeight := nextPowerOfTwo(width), nextPowerOfTwo(height)
+
+	if width == potWidth && height == potHeight {
+		return img
+	}
+
+	scaled := image.NewNRGBA(image.Rect(0, 0, potWidth, potHeight))
+	xdraw.CatmullRom.Scale(scaled, scaled.Bounds(), img, bounds, xdraw.Src, nil)
+	return scaled
+}
@@ -0,0 +1,41 @@
+package render
+
+import (
+	"image"
+	"testing"
+)
+
+func TestNextPowerOfTwo(t *testing.T) {
+	cases := map[int]int{
+		1:   1,
+		2:   2,
+		3:   4,
+		5:   8,
+		16:  16,
+		17:  32,
+		640: 1024,
+	}
+
+	for n, want := range cases {
+		if got := nextPowerOfTwo(n); got != want {
+			t.Errorf("nextPowerOfTwo(%d) = %d, want %d", n, got, want)
+		}
+	}
+}
+
+func TestRescaleToPowerOfTwoNoop(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 64, 64))
+	if got := rescaleToPowerOfTwo(img); got != img {
+		t.Error("rescaleToPowerOfTwo should return the same image when dimensions are already POT")
+	}
+}
+
+func TestRescaleToPowerOfTwoUpscales(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 100, 40))
+	scaled := rescaleToPowerOfTwo(img)
+
+	bounds := scaled.Bounds()
+	if bounds.Dx() != 128 || bounds.Dy() != 64 {
+		t.Errorf("rescaleToPowerOfTwo(100x40) = %dx%d, want 128x64", bounds.Dx(), bounds.Dy())
+	}
+}
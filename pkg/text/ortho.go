@@ -0,0 +1,52 @@
+package text
+
+import (
+	"github.com/Ferguzz/glam"
+)
+
+// VertexShader and FragmentShader are a minimal pass-through shader pair
+// DrawString's quads can be rendered with: position is already in clip-ish
+// 2D space once multiplied by an Ortho projection, and textureCoord samples
+// the glyph atlas via the "tex0" sampler DrawString expects.
+const VertexShader = `
+	#version 150
+
+	in vec2 position;
+	in vec2 textureCoord;
+	out vec2 TextureCoord;
+	uniform mat4 projection;
+
+	void main()
+	{
+	    TextureCoord = textureCoord;
+	    gl_Position = projection * vec4(position, 0.0, 1.0);
+	}
+	`
+
+const FragmentShader = `
+	#version 150
+
+	out vec4 outColor;
+	in vec2 TextureCoord;
+	uniform sampler2D tex0;
+
+	void main()
+	{
+	    outColor = texture(tex0, TextureCoord);
+	}
+`
+
+// Ortho builds a standard orthographic projection matrix mapping
+// [left, right] x [bottom, top] x [near, far] to clip space, so HUD text
+// laid out in pixel coordinates can be drawn over an existing 3D scene
+// without touching its perspective projection.
+func Ortho(left, right, bottom, top, near, far float32) glam.Mat4 {
+	m := glam.Identity()
+	m[0] = 2 / (right - left)
+	m[5] = 2 / (top - bottom)
+	m[10] = -2 / (far - near)
+	m[12] = -(right + left) / (right - left)
+	m[13] = -(top + bottom) / (top - bottom)
+	m[14] = -(far + near) / (far - near)
+	return m
+}
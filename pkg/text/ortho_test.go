@@ -0,0 +1,22 @@
+package text
+
+import "testing"
+
+func TestOrtho(t *testing.T) {
+	m := Ortho(0, 640, 480, 0, -1, 1)
+
+	cases := map[int]float32{
+		0:  2.0 / 640.0,
+		5:  2.0 / -480.0,
+		10: -1.0,
+		12: -1.0,
+		13: 1.0,
+		14: 0.0,
+	}
+
+	for i, want := range cases {
+		if got := m[i]; got != want {
+			t.Errorf("Ortho(...)[%d] = %v, want %v", i, got, want)
+		}
+	}
+}
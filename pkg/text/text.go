@@ -0,0 +1,199 @@
+// Package text renders TrueType text on top of pkg/render: a Font rasterizes
+// a fixed-grid glyph atlas once at load time and uploads it through
+// render.UploadImage, then DrawString batches one textured quad per glyph
+// into a single draw call.
+package text
+
+import (
+	"github.com/Ferguzz/gl_textures/pkg/render"
+	"github.com/go-gl/gl"
+	"github.com/golang/freetype"
+	"github.com/golang/freetype/truetype"
+	"golang.org/x/image/font"
+	"golang.org/x/image/math/fixed"
+	"image"
+	"io/ioutil"
+)
+
+// firstChar/lastChar bound the printable ASCII range packed into the atlas.
+const (
+	firstChar = rune(32)
+	lastChar  = rune(126)
+	atlasCols = 16
+)
+
+type glyph struct {
+	u0, v0, u1, v1 float32
+	advance        float32
+}
+
+// Font is a rasterized glyph atlas plus the metrics needed to lay out and
+// draw strings with it.
+type Font struct {
+	texture    render.Texture
+	glyphs     map[rune]glyph
+	cellWidth  float32
+	cellHeight float32
+	ascent     float32
+}
+
+func fixedToInt(x fixed.Int26_6) int {
+	return int(x+32) >> 6
+}
+
+// LoadFont reads the TrueType font at path, rasterizes the printable ASCII
+// range at the given point size into a single power-of-two atlas, and
+// uploads it onto unit with render.UploadImage. loader is not used: the
+// atlas is neither looked up in nor added to its cache.
+func LoadFont(path string, size float64, loader *render.TextureLoader, unit gl.GLenum) (*Font, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	ttf, err := truetype.Parse(data)
+	if err != nil {
+		return nil, err
+	}
+
+	face := truetype.NewFace(ttf, &truetype.Options{
+		Size:    size,
+		DPI:     72,
+		Hinting: font.HintingFull,
+	})
+	defer face.Close()
+
+	metrics := face.Metrics()
+	cellHeight := fixedToInt(metrics.Height)
+	ascent := fixedToInt(metrics.Ascent)
+
+	cellWidth := 0
+	for r := firstChar; r <= lastChar; r++ {
+		if advance, ok := face.GlyphAdvance(r); ok {
+			if w := fixedToInt(advance); w > cellWidth {
+				cellWidth = w
+			}
+		}
+	}
+
+	numGlyphs := int(lastChar-firstChar) + 1
+	rows := (numGlyphs + atlasCols - 1) / atlasCols
+	atlasWidth := nextPowerOfTwo(atlasCols * cellWidth)
+	atlasHeight := nextPowerOfTwo(rows * cellHeight)
+
+	atlasImg := image.NewNRGBA(image.Rect(0, 0, atlasWidth, atlasHeight))
+
+	ctx := freetype.NewContext()
+	ctx.SetDPI(72)
+	ctx.SetFont(ttf)
+	ctx.SetFontSize(size)
+	ctx.SetClip(atlasImg.Bounds())
+	ctx.SetDst(atlasImg)
+	ctx.SetSrc(image.White)
+
+	glyphs := make(map[rune]glyph)
+	for i := 0; i < numGlyphs; i++ {
+		r := firstChar + rune(i)
+		col, row := i%atlasCols, i/atlasCols
+		originX, originY := col*cellWidth, row*cellHeight+ascent
+
+		if _, err := ctx.DrawString(string(r), freetype.Pt(originX, originY)); err != nil {
+			return nil, err
+		}
+
+		advance := float32(cellWidth)
+		if a, ok := face.GlyphAdvance(r); ok {
+			advance = float32(fixedToInt(a))
+		}
+
+		glyphs[r] = glyph{
+			u0:      float32(col*cellWidth) / float32(atlasWidth),
+			v0:      float32(row*cellHeight) / float32(atlasHeight),
+			u1:      float32(col*cellWidth+cellWidth) / float32(atlasWidth),
+			v1:      float32(row*cellHeight+cellHeight) / float32(atlasHeight),
+			advance: advance,
+		}
+	}
+
+	texture := render.UploadImage(atlasImg, unit, render.TextureOptions{
+		MinFilter: gl.LINEAR,
+		MagFilter: gl.LINEAR,
+	})
+
+	return &Font{
+		texture:    texture,
+		glyphs:     glyphs,
+		cellWidth:  float32(cellWidth),
+		cellHeight: float32(cellHeight),
+		ascent:     float32(ascent),
+	}, nil
+}
+
+// DrawString renders s with its baseline at (x, y) using program, which
+// must declare "position" and "textureCoord" vertex attributes and a
+// sampler2D uniform named "tex0" bound to the texture unit f's atlas was
+// loaded on. It emits one quad per glyph, batched into a single draw call.
+func (f *Font) DrawString(program *render.Program, x, y float32, s string) {
+	vertices := make([]gl.GLfloat, 0, len(s)*16)
+	elements := make([]gl.GLushort, 0, len(s)*6)
+
+	cursor := x
+	var index gl.GLushort
+	for _, r := range s {
+		g, ok := f.glyphs[r]
+		if !ok {
+			continue
+		}
+
+		x0, y0 := cursor, y-f.ascent
+		x1, y1 := cursor+f.cellWidth, y0+f.cellHeight
+		vertices = append(vertices,
+			x0, y0, g.u0, g.v0,
+			x1, y0, g.u1, g.v0,
+			x1, y1, g.u1, g.v1,
+			x0, y1, g.u0, g.v1,
+		)
+		elements = append(elements, index, index+1, index+2, index, index+2, index+3)
+
+		index += 4
+		cursor += g.advance
+	}
+
+	if len(elements) == 0 {
+		return
+	}
+
+	vao := gl.GenVertexArray()
+	vao.Bind()
+	vbo := gl.GenBuffer()
+	vbo.Bind(gl.ARRAY_BUFFER)
+	gl.BufferData(gl.ARRAY_BUFFER, len(vertices)*4, vertices, gl.DYNAMIC_DRAW)
+	ebo := gl.GenBuffer()
+	ebo.Bind(gl.ELEMENT_ARRAY_BUFFER)
+	gl.BufferData(gl.ELEMENT_ARRAY_BUFFER, len(elements)*2, elements, gl.DYNAMIC_DRAW)
+	defer vao.Delete()
+	defer vbo.Delete()
+	defer ebo.Delete()
+
+	positionAttrib := program.GL.GetAttribLocation("position")
+	positionAttrib.AttribPointer(2, gl.FLOAT, false, 4*4, uintptr(0))
+	positionAttrib.EnableArray()
+
+	textureCoordAttrib := program.GL.GetAttribLocation("textureCoord")
+	textureCoordAttrib.AttribPointer(2, gl.FLOAT, false, 4*4, uintptr(2*4))
+	textureCoordAttrib.EnableArray()
+
+	program.Use()
+	f.texture.Bind()
+	program.Uniform("tex0").Uniform1i(int(f.texture.Unit - gl.TEXTURE0))
+
+	gl.DrawElements(gl.TRIANGLES, len(elements), gl.UNSIGNED_SHORT, uintptr(0))
+}
+
+func nextPowerOfTwo(n int) int {
+	p := 1
+	for p < n {
+		p <<= 1
+	}
+	return p
+}